@@ -16,7 +16,7 @@ func InitDB(dbPath string) error {
 		return err
 	}
 
-	err = DB.AutoMigrate(&models.Employee{}, &models.Vacation{})
+	err = DB.AutoMigrate(&models.Employee{}, &models.Vacation{}, &models.Group{}, &models.Holiday{}, &models.Blackout{})
 	if err != nil {
 		return err
 	}
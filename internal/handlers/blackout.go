@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BlackoutHandler manages models.Blackout rows, the block-out periods that
+// VacationHandler.Validate checks prospective vacations against.
+type BlackoutHandler struct {
+	repo repository.BlackoutRepository
+}
+
+func NewBlackoutHandler() BlackoutHandler {
+	return BlackoutHandler{
+		repo: repository.NewBlackoutRepository(),
+	}
+}
+
+type CreateBlackoutRequest struct {
+	// GroupID scopes the blackout to a single team; omit it for a
+	// company-wide blackout.
+	GroupID   *uint  `json:"group_id"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date" binding:"required"`
+	Reason    string `json:"reason"`
+}
+
+func (h BlackoutHandler) GetAll(c *gin.Context) {
+	blackouts, err := h.repo.GetAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, blackouts)
+}
+
+func (h BlackoutHandler) Create(c *gin.Context) {
+	var req CreateBlackoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date format, use YYYY-MM-DD"})
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid end_date format, use YYYY-MM-DD"})
+		return
+	}
+
+	if endDate.Before(startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must be after start_date"})
+		return
+	}
+
+	blackout := models.Blackout{
+		GroupID:   req.GroupID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    req.Reason,
+	}
+
+	created, err := h.repo.Create(blackout)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
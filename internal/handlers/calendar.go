@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"vacation_calendar/internal/services"
@@ -10,12 +11,14 @@ import (
 )
 
 type CalendarHandler struct {
-	service services.CalendarService
+	service       services.CalendarService
+	excelExporter services.ExcelExporter
 }
 
 func NewCalendarHandler() CalendarHandler {
 	return CalendarHandler{
-		service: services.NewCalendarService(),
+		service:       services.NewCalendarService(),
+		excelExporter: services.NewExcelExporter(),
 	}
 }
 
@@ -45,7 +48,26 @@ func (h CalendarHandler) Generate(c *gin.Context) {
 		return
 	}
 
-	imgBytes, err := h.service.GenerateCalendar(from, to)
+	opts := services.CalendarOptions{}
+
+	if minStr := c.Query("min_available"); minStr != "" {
+		opts.MinAvailable, err = strconv.Atoi(minStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid min_available, must be an integer"})
+			return
+		}
+	}
+
+	for _, idStr := range c.QueryArray("group_id") {
+		groupID, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid group_id, must be an integer"})
+			return
+		}
+		opts.GroupIDs = append(opts.GroupIDs, uint(groupID))
+	}
+
+	imgBytes, err := h.service.GenerateCalendar(from, to, opts)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -55,3 +77,24 @@ func (h CalendarHandler) Generate(c *gin.Context) {
 	c.Header("Content-Disposition", "attachment; filename=vacation_calendar.png")
 	c.Data(http.StatusOK, "image/png", imgBytes)
 }
+
+// Export handles GET /api/calendar/export.xlsx, rendering the same [from, to]
+// window as an editable workbook instead of a PNG.
+func (h CalendarHandler) Export(c *gin.Context) {
+	from, to, err := parseRequiredRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	xlsxBytes, err := h.excelExporter.Export(from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	c.Header("Content-Type", xlsxContentType)
+	c.Header("Content-Disposition", "attachment; filename=vacation_calendar.xlsx")
+	c.Data(http.StatusOK, xlsxContentType, xlsxBytes)
+}
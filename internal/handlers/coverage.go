@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"vacation_calendar/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CoverageHandler struct {
+	service services.CoverageService
+}
+
+func NewCoverageHandler() CoverageHandler {
+	return CoverageHandler{
+		service: services.NewCoverageService(),
+	}
+}
+
+// GetCoverage handles GET /api/coverage?from=&to=&min_available=.
+func (h CoverageHandler) GetCoverage(c *gin.Context) {
+	from, to, err := parseRequiredRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	minAvailable := 0
+	if minStr := c.Query("min_available"); minStr != "" {
+		minAvailable, err = strconv.Atoi(minStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid min_available, must be an integer"})
+			return
+		}
+	}
+
+	var groupIDs []uint
+	for _, idStr := range c.QueryArray("group_id") {
+		groupID, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid group_id, must be an integer"})
+			return
+		}
+		groupIDs = append(groupIDs, uint(groupID))
+	}
+
+	coverage, err := h.service.GetCoverage(from, to, minAvailable, groupIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, coverage)
+}
+
+// GetFreeBusy handles GET /api/freebusy?employee_ids=1,2,3&from=&to=.
+func (h CoverageHandler) GetFreeBusy(c *gin.Context) {
+	from, to, err := parseRequiredRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	idsStr := c.Query("employee_ids")
+	if idsStr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "employee_ids query parameter is required"})
+		return
+	}
+
+	employeeIDs, err := parseUintList(idsStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid employee_ids, must be a comma-separated list of integers"})
+		return
+	}
+
+	freeBusy, err := h.service.GetFreeBusy(employeeIDs, from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, freeBusy)
+}
+
+// parseRequiredRange parses the mandatory ?from=&to= query parameters shared
+// by the coverage and freebusy endpoints.
+func parseRequiredRange(c *gin.Context) (time.Time, time.Time, error) {
+	fromStr := c.Query("from")
+	toStr := c.Query("to")
+
+	if fromStr == "" || toStr == "" {
+		return time.Time{}, time.Time{}, errors.New("from and to query parameters are required")
+	}
+
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid from date format, use YYYY-MM-DD")
+	}
+
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid to date format, use YYYY-MM-DD")
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.New("to date must be after from date")
+	}
+
+	return from, to, nil
+}
+
+func parseUintList(s string) ([]uint, error) {
+	parts := strings.Split(s, ",")
+	ids := make([]uint, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
+}
@@ -6,17 +6,20 @@ import (
 
 	"vacation_calendar/internal/models"
 	"vacation_calendar/internal/repository"
+	"vacation_calendar/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
 type EmployeeHandler struct {
-	repo repository.EmployeeRepository
+	repo    repository.EmployeeRepository
+	icalSvc services.ICalendarService
 }
 
 func NewEmployeeHandler() EmployeeHandler {
 	return EmployeeHandler{
-		repo: repository.NewEmployeeRepository(),
+		repo:    repository.NewEmployeeRepository(),
+		icalSvc: services.NewICalendarService(),
 	}
 }
 
@@ -112,6 +115,32 @@ func (h EmployeeHandler) Update(c *gin.Context) {
 	c.JSON(http.StatusOK, updated)
 }
 
+// ICalFeed serves a single employee's vacations as an RFC 5545 calendar,
+// optionally bounded by ?from=&to=.
+func (h EmployeeHandler) ICalFeed(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	from, to, err := parseOptionalRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ics, err := h.icalSvc.GenerateEmployeeFeed(uint(id), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename=employee_vacation_calendar.ics")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", ics)
+}
+
 func (h EmployeeHandler) Delete(c *gin.Context) {
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"vacation_calendar/internal/services/holidays"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HolidayHandler struct {
+	service holidays.Service
+}
+
+func NewHolidayHandler() HolidayHandler {
+	return HolidayHandler{
+		service: holidays.NewService(),
+	}
+}
+
+func (h HolidayHandler) GetAll(c *gin.Context) {
+	list, err := h.service.GetAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// Import handles POST /api/holidays/import, refreshing the configured
+// region from its provider (HOLIDAY_ICS_URL, or the bundled set).
+func (h HolidayHandler) Import(c *gin.Context) {
+	imported, err := h.service.Refresh()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"imported": len(imported), "holidays": imported})
+}
@@ -1,31 +1,114 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
 	"vacation_calendar/internal/models"
 	"vacation_calendar/internal/repository"
+	"vacation_calendar/internal/services"
+	"vacation_calendar/internal/services/holidays"
+	"vacation_calendar/internal/services/recurrence"
 
 	"github.com/gin-gonic/gin"
 )
 
 type VacationHandler struct {
-	repo repository.VacationRepository
+	repo         repository.VacationRepository
+	blackoutRepo repository.BlackoutRepository
+	icalSvc      services.ICalendarService
+	holidaySvc   holidays.Service
 }
 
 func NewVacationHandler() VacationHandler {
 	return VacationHandler{
-		repo: repository.NewVacationRepository(),
+		repo:         repository.NewVacationRepository(),
+		blackoutRepo: repository.NewBlackoutRepository(),
+		icalSvc:      services.NewICalendarService(),
+		holidaySvc:   holidays.NewService(),
 	}
 }
 
+// conflictingIDs extracts the IDs from a set of overlapping vacations, for
+// use in a 409 Conflict response body.
+func conflictingIDs(vacations []models.Vacation) []uint {
+	ids := make([]uint, len(vacations))
+	for i, v := range vacations {
+		ids[i] = v.ID
+	}
+	return ids
+}
+
+// VacationResponse is a models.Vacation enriched with the true number of
+// working days it consumes, excluding weekends and public holidays.
+type VacationResponse struct {
+	models.Vacation
+	WorkingDays int `json:"working_days"`
+}
+
+// withWorkingDays computes WorkingDays for v and wraps it in a
+// VacationResponse.
+func (h VacationHandler) withWorkingDays(v models.Vacation) (VacationResponse, error) {
+	days, err := h.workingDays(v.StartDate, v.EndDate)
+	if err != nil {
+		return VacationResponse{}, err
+	}
+	return VacationResponse{Vacation: v, WorkingDays: days}, nil
+}
+
+// withWorkingDaysBatch enriches a batch of vacations with their true
+// working-day counts, fetching the holidays covering the whole batch once
+// instead of once per row (as withWorkingDays would if called in a loop).
+func (h VacationHandler) withWorkingDaysBatch(vacations []models.Vacation) ([]VacationResponse, error) {
+	if len(vacations) == 0 {
+		return []VacationResponse{}, nil
+	}
+
+	from, to := vacations[0].StartDate, vacations[0].EndDate
+	for _, v := range vacations[1:] {
+		if v.StartDate.Before(from) {
+			from = v.StartDate
+		}
+		if v.EndDate.After(to) {
+			to = v.EndDate
+		}
+	}
+
+	holidayList, err := h.holidaySvc.GetByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	isHoliday := holidays.ToDateSet(holidayList)
+
+	responses := make([]VacationResponse, 0, len(vacations))
+	for _, v := range vacations {
+		responses = append(responses, VacationResponse{
+			Vacation:    v,
+			WorkingDays: holidays.WorkingDays(v.StartDate, v.EndDate, isHoliday),
+		})
+	}
+	return responses, nil
+}
+
+// workingDays counts the days in [start, end] that are neither a weekend
+// nor a public holiday.
+func (h VacationHandler) workingDays(start, end time.Time) (int, error) {
+	holidayList, err := h.holidaySvc.GetByDateRange(start, end)
+	if err != nil {
+		return 0, err
+	}
+	return holidays.WorkingDays(start, end, holidays.ToDateSet(holidayList)), nil
+}
+
 type CreateVacationRequest struct {
 	EmployeeID  uint   `json:"employee_id" binding:"required"`
 	StartDate   string `json:"start_date" binding:"required"`
 	EndDate     string `json:"end_date" binding:"required"`
 	Description string `json:"description"`
+	RRule       string `json:"rrule"`
+	ExDates     string `json:"ex_dates"`
 }
 
 type UpdateVacationRequest struct {
@@ -33,6 +116,8 @@ type UpdateVacationRequest struct {
 	StartDate   string `json:"start_date"`
 	EndDate     string `json:"end_date"`
 	Description string `json:"description"`
+	RRule       string `json:"rrule"`
+	ExDates     string `json:"ex_dates"`
 }
 
 func (h VacationHandler) GetAll(c *gin.Context) {
@@ -51,7 +136,21 @@ func (h VacationHandler) GetAll(c *gin.Context) {
 			return
 		}
 
-		vacations, err := h.repo.GetByDateRange(from, to)
+		occurrences, err := h.repo.GetOccurrencesByDateRange(from, to)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		expanded := make([]models.Vacation, 0, len(occurrences))
+		for _, occ := range occurrences {
+			v := occ.Vacation
+			v.StartDate = occ.Start
+			v.EndDate = occ.End
+			expanded = append(expanded, v)
+		}
+
+		vacations, err := h.withWorkingDaysBatch(expanded)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -60,7 +159,13 @@ func (h VacationHandler) GetAll(c *gin.Context) {
 		return
 	}
 
-	vacations, err := h.repo.GetAll()
+	all, err := h.repo.GetAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	vacations, err := h.withWorkingDaysBatch(all)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -80,7 +185,13 @@ func (h VacationHandler) GetByID(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "vacation not found"})
 		return
 	}
-	c.JSON(http.StatusOK, vacation)
+
+	enriched, err := h.withWorkingDays(vacation)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, enriched)
 }
 
 func (h VacationHandler) Create(c *gin.Context) {
@@ -107,6 +218,11 @@ func (h VacationHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if err := recurrence.ValidateRRule(req.RRule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rrule: " + err.Error()})
+		return
+	}
+
 	employeeRepo := repository.NewEmployeeRepository()
 	_, err = employeeRepo.GetByID(req.EmployeeID)
 	if err != nil {
@@ -114,11 +230,26 @@ func (h VacationHandler) Create(c *gin.Context) {
 		return
 	}
 
+	conflicts, err := h.repo.FindOverlapping(req.EmployeeID, startDate, endDate, 0)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if len(conflicts) > 0 {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":           "overlaps with an existing vacation for this employee",
+			"conflicting_ids": conflictingIDs(conflicts),
+		})
+		return
+	}
+
 	vacation := models.Vacation{
 		EmployeeID:  req.EmployeeID,
 		StartDate:   startDate,
 		EndDate:     endDate,
 		Description: req.Description,
+		RRule:       req.RRule,
+		ExDates:     req.ExDates,
 	}
 
 	created, err := h.repo.Create(vacation)
@@ -126,7 +257,13 @@ func (h VacationHandler) Create(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusCreated, created)
+
+	enriched, err := h.withWorkingDays(created)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, enriched)
 }
 
 func (h VacationHandler) Update(c *gin.Context) {
@@ -181,14 +318,271 @@ func (h VacationHandler) Update(c *gin.Context) {
 		return
 	}
 
+	if err := recurrence.ValidateRRule(req.RRule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rrule: " + err.Error()})
+		return
+	}
+
+	conflicts, err := h.repo.FindOverlapping(vacation.EmployeeID, vacation.StartDate, vacation.EndDate, vacation.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if len(conflicts) > 0 {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":           "overlaps with an existing vacation for this employee",
+			"conflicting_ids": conflictingIDs(conflicts),
+		})
+		return
+	}
+
 	vacation.Description = req.Description
+	if req.RRule != "" {
+		vacation.RRule = req.RRule
+	}
+	if req.ExDates != "" {
+		vacation.ExDates = req.ExDates
+	}
 
 	updated, err := h.repo.Update(vacation)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, updated)
+
+	enriched, err := h.withWorkingDays(updated)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, enriched)
+}
+
+// ValidateVacationRequest is a dry-run of CreateVacationRequest: it carries
+// the same date fields plus the optional team-capacity rule to check,
+// without ever persisting anything.
+type ValidateVacationRequest struct {
+	EmployeeID uint   `json:"employee_id" binding:"required"`
+	StartDate  string `json:"start_date" binding:"required"`
+	EndDate    string `json:"end_date" binding:"required"`
+	// GroupID and MaxGroupAbsence, when both set, enforce "no more than
+	// MaxGroupAbsence members of GroupID off on the same day".
+	GroupID         uint `json:"group_id"`
+	MaxGroupAbsence int  `json:"max_group_absence"`
+}
+
+// Violation describes a single policy problem found while validating a
+// prospective vacation, so the frontend can render each as an inline
+// warning instead of a single opaque error string.
+type Violation struct {
+	Type           string `json:"type"`
+	Detail         string `json:"detail"`
+	ConflictingIDs []uint `json:"conflicting_ids,omitempty"`
+}
+
+type ValidateVacationResponse struct {
+	Valid      bool        `json:"valid"`
+	Violations []Violation `json:"violations"`
+}
+
+// Validate handles POST /api/vacations/validate: a dry run that reports
+// every rule a prospective vacation would break (overlap, blackout period,
+// group capacity) without creating anything.
+func (h VacationHandler) Validate(c *gin.Context) {
+	var req ValidateVacationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid start_date format, use YYYY-MM-DD"})
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid end_date format, use YYYY-MM-DD"})
+		return
+	}
+
+	if endDate.Before(startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must be after start_date"})
+		return
+	}
+
+	var violations []Violation
+
+	conflicts, err := h.repo.FindOverlapping(req.EmployeeID, startDate, endDate, 0)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if len(conflicts) > 0 {
+		violations = append(violations, Violation{
+			Type:           "overlap",
+			Detail:         "overlaps with an existing vacation for this employee",
+			ConflictingIDs: conflictingIDs(conflicts),
+		})
+	}
+
+	blackoutViolations, err := h.blackoutViolations(req.GroupID, startDate, endDate)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	violations = append(violations, blackoutViolations...)
+
+	if req.GroupID != 0 && req.MaxGroupAbsence > 0 {
+		violation, err := h.groupCapacityViolation(req.GroupID, req.MaxGroupAbsence, req.EmployeeID, startDate, endDate)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	if violations == nil {
+		violations = []Violation{}
+	}
+	c.JSON(http.StatusOK, ValidateVacationResponse{Valid: len(violations) == 0, Violations: violations})
+}
+
+// blackoutViolations reports every stored blackout overlapping [start, end]
+// that applies to groupID (0 meaning "no group given", which still catches
+// company-wide blackouts).
+func (h VacationHandler) blackoutViolations(groupID uint, start, end time.Time) ([]Violation, error) {
+	blackouts, err := h.blackoutRepo.GetOverlapping(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []Violation
+	for _, b := range blackouts {
+		if b.GroupID != nil && *b.GroupID != groupID {
+			continue
+		}
+		violations = append(violations, Violation{
+			Type:   "blackout",
+			Detail: fmt.Sprintf("overlaps blackout period %s to %s (%s)", b.StartDate.Format("2006-01-02"), b.EndDate.Format("2006-01-02"), b.Reason),
+		})
+	}
+	return violations, nil
+}
+
+// groupCapacityViolation reports a "no more than maxAbsence of groupID off
+// on the same day" breach, checking every day in [start, end] against the
+// group's existing occurrences plus the prospective vacation itself.
+func (h VacationHandler) groupCapacityViolation(groupID uint, maxAbsence int, employeeID uint, start, end time.Time) (*Violation, error) {
+	employeeRepo := repository.NewEmployeeRepository()
+	members, err := employeeRepo.GetByGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	inGroup := make(map[uint]bool, len(members))
+	for _, m := range members {
+		inGroup[m.ID] = true
+	}
+
+	occurrences, err := h.repo.GetOccurrencesByDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(end.Sub(start).Hours()/24) + 1
+	busyByDay := make([]map[uint]bool, days)
+	for i := range busyByDay {
+		busyByDay[i] = make(map[uint]bool)
+	}
+
+	addBusy := func(empID uint, occStart, occEnd time.Time) {
+		if !inGroup[empID] {
+			return
+		}
+		if occStart.Before(start) {
+			occStart = start
+		}
+		if occEnd.After(end) {
+			occEnd = end
+		}
+		startIdx := int(occStart.Sub(start).Hours() / 24)
+		endIdx := int(occEnd.Sub(start).Hours() / 24)
+		for d := startIdx; d <= endIdx && d < days; d++ {
+			if d < 0 {
+				continue
+			}
+			busyByDay[d][empID] = true
+		}
+	}
+
+	for _, occ := range occurrences {
+		addBusy(occ.Vacation.EmployeeID, occ.Start, occ.End)
+	}
+	addBusy(employeeID, start, end)
+
+	worstDay, worstCount := -1, 0
+	for d, busy := range busyByDay {
+		if len(busy) > maxAbsence && len(busy) > worstCount {
+			worstDay, worstCount = d, len(busy)
+		}
+	}
+	if worstDay == -1 {
+		return nil, nil
+	}
+
+	date := start.AddDate(0, 0, worstDay)
+	return &Violation{
+		Type:   "group_capacity",
+		Detail: fmt.Sprintf("%d members of the group would be off on %s, exceeding the limit of %d", worstCount, date.Format("2006-01-02"), maxAbsence),
+	}, nil
+}
+
+// ICalFeed serves every vacation as an RFC 5545 calendar, optionally bounded
+// by ?from=&to=, so it can be subscribed to from Google Calendar / Apple
+// Calendar / Thunderbird.
+func (h VacationHandler) ICalFeed(c *gin.Context) {
+	from, to, err := parseOptionalRange(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ics, err := h.icalSvc.GenerateFeed(from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename=vacation_calendar.ics")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", ics)
+}
+
+// parseOptionalRange parses the ?from=&to= query parameters if both are
+// present, returning zero times when they are omitted.
+func parseOptionalRange(c *gin.Context) (time.Time, time.Time, error) {
+	fromStr := c.Query("from")
+	toStr := c.Query("to")
+
+	if fromStr == "" || toStr == "" {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return from, to, nil
 }
 
 func (h VacationHandler) Delete(c *gin.Context) {
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// Blackout is a period during which vacation requests are flagged as a
+// policy violation, e.g. a launch freeze or fiscal year-end close.
+// GroupID, when set, scopes the blackout to a single team rather than
+// the whole company.
+type Blackout struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	GroupID   *uint     `json:"group_id,omitempty"`
+	StartDate time.Time `json:"start_date" gorm:"not null"`
+	EndDate   time.Time `json:"end_date" gorm:"not null"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -5,10 +5,11 @@ import (
 )
 
 type Employee struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`
-	Name      string    `json:"name" gorm:"not null"`
-	Color     string    `json:"color" gorm:"default:'#3498db'"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	Name      string     `json:"name" gorm:"not null"`
+	Color     string     `json:"color" gorm:"default:'#3498db'"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
 	Vacations []Vacation `json:"vacations,omitempty" gorm:"foreignKey:EmployeeID"`
+	Groups    []Group    `json:"groups,omitempty" gorm:"many2many:employee_groups;"`
 }
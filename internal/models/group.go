@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+type Group struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	Name      string     `json:"name" gorm:"not null"`
+	Color     string     `json:"color" gorm:"default:'#95a5a6'"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Employees []Employee `json:"employees,omitempty" gorm:"many2many:employee_groups;"`
+}
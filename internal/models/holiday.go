@@ -0,0 +1,12 @@
+package models
+
+import (
+	"time"
+)
+
+type Holiday struct {
+	ID     uint      `json:"id" gorm:"primaryKey"`
+	Date   time.Time `json:"date" gorm:"not null;uniqueIndex:idx_holiday_date_region"`
+	Name   string    `json:"name" gorm:"not null"`
+	Region string    `json:"region" gorm:"uniqueIndex:idx_holiday_date_region"`
+}
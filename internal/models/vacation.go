@@ -10,7 +10,13 @@ type Vacation struct {
 	StartDate   time.Time `json:"start_date" gorm:"not null"`
 	EndDate     time.Time `json:"end_date" gorm:"not null"`
 	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Employee    Employee  `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
+	// RRule is an RFC 5545 recurrence rule (e.g. "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24").
+	// An empty RRule means the vacation is a single, non-recurring occurrence.
+	RRule string `json:"rrule,omitempty"`
+	// ExDates is a comma-separated list of YYYY-MM-DD dates excluded from
+	// the recurrence described by RRule.
+	ExDates   string    `json:"ex_dates,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Employee  Employee  `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
 }
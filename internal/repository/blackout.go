@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"time"
+
+	"vacation_calendar/internal/database"
+	"vacation_calendar/internal/models"
+)
+
+type BlackoutRepository struct{}
+
+func NewBlackoutRepository() BlackoutRepository {
+	return BlackoutRepository{}
+}
+
+func (r BlackoutRepository) GetAll() ([]models.Blackout, error) {
+	var blackouts []models.Blackout
+	result := database.GetDB().Order("start_date").Find(&blackouts)
+	return blackouts, result.Error
+}
+
+// GetOverlapping returns every blackout (company-wide or group-scoped) that
+// overlaps [start, end].
+func (r BlackoutRepository) GetOverlapping(start, end time.Time) ([]models.Blackout, error) {
+	var blackouts []models.Blackout
+	result := database.GetDB().
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Find(&blackouts)
+	return blackouts, result.Error
+}
+
+func (r BlackoutRepository) Create(blackout models.Blackout) (models.Blackout, error) {
+	result := database.GetDB().Create(&blackout)
+	return blackout, result.Error
+}
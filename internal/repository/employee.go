@@ -34,12 +34,43 @@ func (r EmployeeRepository) Update(employee models.Employee) (models.Employee, e
 }
 
 func (r EmployeeRepository) Delete(id uint) error {
-	result := database.GetDB().Delete(&models.Employee{}, id)
-	return result.Error
+	db := database.GetDB()
+	if err := db.Model(&models.Employee{ID: id}).Association("Groups").Clear(); err != nil {
+		return err
+	}
+	return db.Delete(&models.Employee{}, id).Error
 }
 
-func (r EmployeeRepository) GetAllWithVacations() ([]models.Employee, error) {
+// GetByGroup returns every employee that belongs to the given group.
+func (r EmployeeRepository) GetByGroup(groupID uint) ([]models.Employee, error) {
 	var employees []models.Employee
-	result := database.GetDB().Preload("Vacations").Find(&employees)
+	result := database.GetDB().
+		Joins("JOIN employee_groups ON employee_groups.employee_id = employees.id").
+		Where("employee_groups.group_id = ?", groupID).
+		Find(&employees)
 	return employees, result.Error
 }
+
+// GetInScope returns every employee when groupIDs is empty, or the union of
+// members across the given groups otherwise.
+func (r EmployeeRepository) GetInScope(groupIDs []uint) ([]models.Employee, error) {
+	if len(groupIDs) == 0 {
+		return r.GetAll()
+	}
+
+	seen := make(map[uint]bool)
+	var employees []models.Employee
+	for _, groupID := range groupIDs {
+		members, err := r.GetByGroup(groupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range members {
+			if !seen[e.ID] {
+				seen[e.ID] = true
+				employees = append(employees, e)
+			}
+		}
+	}
+	return employees, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"vacation_calendar/internal/database"
+	"vacation_calendar/internal/models"
+)
+
+type GroupRepository struct{}
+
+func NewGroupRepository() GroupRepository {
+	return GroupRepository{}
+}
+
+func (r GroupRepository) GetAll() ([]models.Group, error) {
+	var groups []models.Group
+	result := database.GetDB().Find(&groups)
+	return groups, result.Error
+}
+
+func (r GroupRepository) GetByID(id uint) (models.Group, error) {
+	var group models.Group
+	result := database.GetDB().Preload("Employees").First(&group, id)
+	return group, result.Error
+}
+
+func (r GroupRepository) Create(group models.Group) (models.Group, error) {
+	result := database.GetDB().Create(&group)
+	return group, result.Error
+}
+
+func (r GroupRepository) Update(group models.Group) (models.Group, error) {
+	result := database.GetDB().Save(&group)
+	return group, result.Error
+}
+
+func (r GroupRepository) Delete(id uint) error {
+	db := database.GetDB()
+	if err := db.Model(&models.Group{ID: id}).Association("Employees").Clear(); err != nil {
+		return err
+	}
+	return db.Delete(&models.Group{}, id).Error
+}
+
+// AddMember adds an employee to a group, creating the employee_groups row.
+func (r GroupRepository) AddMember(groupID, employeeID uint) error {
+	group := models.Group{ID: groupID}
+	employee := models.Employee{ID: employeeID}
+	return database.GetDB().Model(&group).Association("Employees").Append(&employee)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"time"
+
+	"vacation_calendar/internal/database"
+	"vacation_calendar/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type HolidayRepository struct{}
+
+func NewHolidayRepository() HolidayRepository {
+	return HolidayRepository{}
+}
+
+func (r HolidayRepository) GetAll() ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	result := database.GetDB().Order("date").Find(&holidays)
+	return holidays, result.Error
+}
+
+func (r HolidayRepository) GetByDateRange(from, to time.Time, region string) ([]models.Holiday, error) {
+	query := database.GetDB().Where("date >= ? AND date <= ?", from, to)
+	if region != "" {
+		query = query.Where("region = ?", region)
+	}
+
+	var holidays []models.Holiday
+	result := query.Order("date").Find(&holidays)
+	return holidays, result.Error
+}
+
+// Replace atomically swaps out every stored holiday for a region with a
+// freshly fetched set, so re-importing never leaves stale duplicates.
+func (r HolidayRepository) Replace(region string, holidays []models.Holiday) error {
+	return database.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("region = ?", region).Delete(&models.Holiday{}).Error; err != nil {
+			return err
+		}
+		if len(holidays) == 0 {
+			return nil
+		}
+		return tx.Create(&holidays).Error
+	})
+}
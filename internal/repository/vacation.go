@@ -5,6 +5,7 @@ import (
 
 	"vacation_calendar/internal/database"
 	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/services/recurrence"
 )
 
 type VacationRepository struct{}
@@ -33,9 +34,61 @@ func (r VacationRepository) GetByDateRange(from, to time.Time) ([]models.Vacatio
 	return vacations, result.Error
 }
 
+// GetOccurrencesByDateRange expands every vacation overlapping [from, to]
+// into its concrete occurrences, honoring RRule/ExDates for recurring ones.
+func (r VacationRepository) GetOccurrencesByDateRange(from, to time.Time) ([]recurrence.Occurrence, error) {
+	var vacations []models.Vacation
+	// A recurring vacation's original start_date can predate `from` by a
+	// lot (e.g. a yearly recurrence set up years ago), so recurring rows
+	// are fetched regardless of their own start/end and left to Expand to
+	// clamp to the window.
+	result := database.GetDB().Preload("Employee").
+		Where("(start_date <= ? AND end_date >= ?) OR rrule <> ''", to, from).
+		Find(&vacations)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var occurrences []recurrence.Occurrence
+	for _, v := range vacations {
+		occs, err := recurrence.Expand(v, from, to)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, occs...)
+	}
+	return occurrences, nil
+}
+
+// FindOverlapping returns the employee's existing vacations - recurring or
+// not - that have an occurrence overlapping [start, end], excluding the
+// vacation identified by excludeID (pass 0 when checking a not-yet-created
+// vacation). This goes through GetOccurrencesByDateRange rather than a raw
+// row comparison so a recurring vacation (e.g. "every Friday off") is
+// caught even when the new request falls on a future occurrence outside
+// its originally stored start/end.
+func (r VacationRepository) FindOverlapping(employeeID uint, start, end time.Time, excludeID uint) ([]models.Vacation, error) {
+	occurrences, err := r.GetOccurrencesByDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]bool)
+	var conflicts []models.Vacation
+	for _, occ := range occurrences {
+		v := occ.Vacation
+		if v.EmployeeID != employeeID || v.ID == excludeID || seen[v.ID] {
+			continue
+		}
+		seen[v.ID] = true
+		conflicts = append(conflicts, v)
+	}
+	return conflicts, nil
+}
+
 func (r VacationRepository) GetByEmployeeID(employeeID uint) ([]models.Vacation, error) {
 	var vacations []models.Vacation
-	result := database.GetDB().Where("employee_id = ?", employeeID).Find(&vacations)
+	result := database.GetDB().Preload("Employee").Where("employee_id = ?", employeeID).Find(&vacations)
 	return vacations, result.Error
 }
 
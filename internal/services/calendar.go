@@ -6,10 +6,12 @@ import (
 	"image/color"
 	"image/png"
 	"strconv"
+	"strings"
 	"time"
 
 	"vacation_calendar/internal/models"
 	"vacation_calendar/internal/repository"
+	"vacation_calendar/internal/services/holidays"
 
 	"github.com/fogleman/gg"
 )
@@ -20,12 +22,16 @@ const fontPathBold = "fonts/Roboto-Bold.ttf"
 type CalendarService struct {
 	employeeRepo repository.EmployeeRepository
 	vacationRepo repository.VacationRepository
+	coverageSvc  CoverageService
+	holidaySvc   holidays.Service
 }
 
 func NewCalendarService() CalendarService {
 	return CalendarService{
 		employeeRepo: repository.NewEmployeeRepository(),
 		vacationRepo: repository.NewVacationRepository(),
+		coverageSvc:  NewCoverageService(),
+		holidaySvc:   holidays.NewService(),
 	}
 }
 
@@ -36,33 +42,62 @@ const (
 	dayWidth      = 30
 	headerHeight  = 50
 	bottomPadding = 20
+	legendHeight  = 20
 )
 
-func (s CalendarService) GenerateCalendar(from, to time.Time) ([]byte, error) {
-	employees, err := s.employeeRepo.GetAll()
+// CalendarOptions configures optional PNG rendering behavior beyond the
+// [from, to] window.
+type CalendarOptions struct {
+	// MinAvailable, when greater than zero, overlays a red "understaffed"
+	// band on the date columns where fewer employees are available.
+	MinAvailable int
+	// GroupIDs, when non-empty, restricts the rendered rows to employees
+	// belonging to any of these groups.
+	GroupIDs []uint
+}
+
+// GenerateCalendar renders the team calendar as a PNG.
+func (s CalendarService) GenerateCalendar(from, to time.Time, opts CalendarOptions) ([]byte, error) {
+	employees, err := s.employeeRepo.GetInScope(opts.GroupIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	vacations, err := s.vacationRepo.GetByDateRange(from, to)
+	occurrences, err := s.vacationRepo.GetOccurrencesByDateRange(from, to)
 	if err != nil {
 		return nil, err
 	}
 
+	inScope := make(map[uint]bool, len(employees))
+	for _, e := range employees {
+		inScope[e.ID] = true
+	}
+
 	vacationMap := make(map[uint][]struct {
 		Start time.Time
 		End   time.Time
 	})
-	for _, v := range vacations {
-		vacationMap[v.EmployeeID] = append(vacationMap[v.EmployeeID], struct {
+	for _, occ := range occurrences {
+		if !inScope[occ.Vacation.EmployeeID] {
+			continue
+		}
+		vacationMap[occ.Vacation.EmployeeID] = append(vacationMap[occ.Vacation.EmployeeID], struct {
 			Start time.Time
 			End   time.Time
-		}{v.StartDate, v.EndDate})
+		}{occ.Start, occ.End})
+	}
+
+	holidayList, err := s.holidaySvc.GetByDateRange(from, to)
+	if err != nil {
+		return nil, err
 	}
 
 	days := int(to.Sub(from).Hours()/24) + 1
 	width := leftMargin + days*dayWidth + 20
 	height := topMargin + headerHeight + len(employees)*rowHeight + bottomPadding
+	if len(holidayList) > 0 {
+		height += legendHeight
+	}
 
 	if width < 800 {
 		width = 800
@@ -90,9 +125,19 @@ func (s CalendarService) GenerateCalendar(from, to time.Time) ([]byte, error) {
 	}
 
 	s.drawDateHeaders(dc, from, days)
-	s.drawWeekendBackground(dc, from, days, len(employees))
+	s.drawWeekendBackground(dc, from, days, len(employees), holidayList)
+
+	if opts.MinAvailable > 0 {
+		coverage, err := s.coverageSvc.GetCoverage(from, to, opts.MinAvailable, opts.GroupIDs)
+		if err != nil {
+			return nil, err
+		}
+		s.drawUnderstaffedBand(dc, from, days, len(employees), coverage)
+	}
+
 	s.drawEmployeeRows(dc, employees, vacationMap, from, to, days)
 	s.drawGrid(dc, days, len(employees))
+	s.drawHolidayLegend(dc, len(employees), holidayList)
 
 	img := dc.Image()
 	var buf bytes.Buffer
@@ -120,20 +165,74 @@ func (s CalendarService) drawDateHeaders(dc *gg.Context, from time.Time, days in
 	}
 }
 
-func (s CalendarService) drawWeekendBackground(dc *gg.Context, from time.Time, days int, employeeCount int) {
+// drawWeekendBackground shades weekend columns gray and holiday columns a
+// distinct amber, so both stand out from working days at a glance.
+func (s CalendarService) drawWeekendBackground(dc *gg.Context, from time.Time, days int, employeeCount int, holidayList []models.Holiday) {
 	weekendColor := color.RGBA{240, 240, 240, 255}
+	holidayColor := color.RGBA{255, 224, 178, 255}
+
+	holidayNames := make(map[string]bool, len(holidayList))
+	for _, hol := range holidayList {
+		holidayNames[hol.Date.Format("2006-01-02")] = true
+	}
 
 	for i := 0; i < days; i++ {
 		date := from.AddDate(0, 0, i)
-		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
-			x := float64(leftMargin + i*dayWidth)
-			y := float64(topMargin + headerHeight)
-			h := float64(employeeCount * rowHeight)
+		isHoliday := holidayNames[date.Format("2006-01-02")]
+		isWeekend := date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+
+		if !isHoliday && !isWeekend {
+			continue
+		}
 
+		x := float64(leftMargin + i*dayWidth)
+		y := float64(topMargin + headerHeight)
+		h := float64(employeeCount * rowHeight)
+
+		if isHoliday {
+			dc.SetColor(holidayColor)
+		} else {
 			dc.SetColor(weekendColor)
-			dc.DrawRectangle(x, y, float64(dayWidth), h)
-			dc.Fill()
 		}
+		dc.DrawRectangle(x, y, float64(dayWidth), h)
+		dc.Fill()
+	}
+}
+
+// drawHolidayLegend lists each holiday shown in the calendar below the grid,
+// since a static PNG has no tooltip to carry the name.
+func (s CalendarService) drawHolidayLegend(dc *gg.Context, employeeCount int, holidayList []models.Holiday) {
+	if len(holidayList) == 0 {
+		return
+	}
+
+	entries := make([]string, 0, len(holidayList))
+	for _, hol := range holidayList {
+		entries = append(entries, fmt.Sprintf("%s: %s", hol.Date.Format("Jan 2"), hol.Name))
+	}
+
+	dc.SetColor(color.Black)
+	legendY := float64(topMargin + headerHeight + employeeCount*rowHeight + legendHeight/2)
+	dc.DrawStringAnchored("Holidays - "+strings.Join(entries, ", "), float64(leftMargin), legendY, 0, 0.5)
+}
+
+// drawUnderstaffedBand overlays a translucent red band on the date columns
+// where coverage reports availability below the configured threshold.
+func (s CalendarService) drawUnderstaffedBand(dc *gg.Context, from time.Time, days int, employeeCount int, coverage []DayCoverage) {
+	understaffedColor := color.RGBA{220, 53, 69, 90}
+
+	for i, day := range coverage {
+		if i >= days || !day.Understaffed {
+			continue
+		}
+
+		x := float64(leftMargin + i*dayWidth)
+		y := float64(topMargin + headerHeight)
+		h := float64(employeeCount * rowHeight)
+
+		dc.SetColor(understaffedColor)
+		dc.DrawRectangle(x, y, float64(dayWidth), h)
+		dc.Fill()
 	}
 }
 
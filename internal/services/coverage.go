@@ -0,0 +1,160 @@
+package services
+
+import (
+	"time"
+
+	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/repository"
+)
+
+// CoverageService answers "who's around" questions over a date range: team
+// coverage per day, and per-employee free/busy intervals for scheduling.
+type CoverageService struct {
+	employeeRepo repository.EmployeeRepository
+	vacationRepo repository.VacationRepository
+}
+
+func NewCoverageService() CoverageService {
+	return CoverageService{
+		employeeRepo: repository.NewEmployeeRepository(),
+		vacationRepo: repository.NewVacationRepository(),
+	}
+}
+
+// DayCoverage summarizes who is on vacation and who is available for a
+// single day.
+type DayCoverage struct {
+	Date           time.Time         `json:"date"`
+	OnVacation     []models.Employee `json:"on_vacation"`
+	Available      []models.Employee `json:"available"`
+	AvailableCount int               `json:"available_count"`
+	Understaffed   bool              `json:"understaffed"`
+}
+
+// BusyInterval is a single contiguous vacation span for an employee.
+type BusyInterval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// EmployeeFreeBusy is one employee's busy intervals within the query window.
+type EmployeeFreeBusy struct {
+	EmployeeID uint           `json:"employee_id"`
+	Busy       []BusyInterval `json:"busy"`
+}
+
+// GetCoverage returns, for each day in [from, to], the employees on vacation
+// and available, flagging days where availability drops below minAvailable
+// (a minAvailable <= 0 disables the understaffed flag). groupIDs, when
+// non-empty, restricts the employees considered to the union of those
+// groups' members, mirroring CalendarService's group-scoped rendering.
+func (s CoverageService) GetCoverage(from, to time.Time, minAvailable int, groupIDs []uint) ([]DayCoverage, error) {
+	employees, err := s.employeeRepo.GetInScope(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	busyByDay, err := s.dailyBusyBitmap(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	days := len(busyByDay)
+	result := make([]DayCoverage, 0, days)
+	for d := 0; d < days; d++ {
+		var onVacation, available []models.Employee
+		for _, e := range employees {
+			if busyByDay[d][e.ID] {
+				onVacation = append(onVacation, e)
+			} else {
+				available = append(available, e)
+			}
+		}
+
+		result = append(result, DayCoverage{
+			Date:           from.AddDate(0, 0, d),
+			OnVacation:     onVacation,
+			Available:      available,
+			AvailableCount: len(available),
+			Understaffed:   minAvailable > 0 && len(available) < minAvailable,
+		})
+	}
+	return result, nil
+}
+
+// GetFreeBusy returns each requested employee's busy intervals within
+// [from, to], suitable for a scheduling UI.
+func (s CoverageService) GetFreeBusy(employeeIDs []uint, from, to time.Time) ([]EmployeeFreeBusy, error) {
+	occurrences, err := s.vacationRepo.GetOccurrencesByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[uint]bool, len(employeeIDs))
+	for _, id := range employeeIDs {
+		wanted[id] = true
+	}
+
+	busy := make(map[uint][]BusyInterval, len(employeeIDs))
+	for _, id := range employeeIDs {
+		busy[id] = []BusyInterval{}
+	}
+
+	for _, occ := range occurrences {
+		empID := occ.Vacation.EmployeeID
+		if !wanted[empID] {
+			continue
+		}
+		start, end := occ.Start, occ.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		busy[empID] = append(busy[empID], BusyInterval{Start: start, End: end})
+	}
+
+	result := make([]EmployeeFreeBusy, 0, len(employeeIDs))
+	for _, id := range employeeIDs {
+		result = append(result, EmployeeFreeBusy{EmployeeID: id, Busy: busy[id]})
+	}
+	return result, nil
+}
+
+// dailyBusyBitmap builds a per-day set of employee IDs on vacation, walking
+// each vacation occurrence once rather than each (day, vacation) pair, so
+// the work is O(days + vacations) instead of O(days * vacations).
+func (s CoverageService) dailyBusyBitmap(from, to time.Time) ([]map[uint]bool, error) {
+	occurrences, err := s.vacationRepo.GetOccurrencesByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	busyByDay := make([]map[uint]bool, days)
+	for i := range busyByDay {
+		busyByDay[i] = make(map[uint]bool)
+	}
+
+	for _, occ := range occurrences {
+		start, end := occ.Start, occ.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+
+		startDay := int(start.Sub(from).Hours() / 24)
+		endDay := int(end.Sub(from).Hours() / 24)
+		for d := startDay; d <= endDay && d < days; d++ {
+			if d < 0 {
+				continue
+			}
+			busyByDay[d][occ.Vacation.EmployeeID] = true
+		}
+	}
+
+	return busyByDay, nil
+}
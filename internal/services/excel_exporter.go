@@ -0,0 +1,212 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/repository"
+	"vacation_calendar/internal/services/holidays"
+	"vacation_calendar/internal/services/recurrence"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	calendarSheetName  = "Calendar"
+	vacationsSheetName = "Vacations"
+)
+
+// ExcelExporter renders the vacation calendar as an .xlsx workbook, for
+// stakeholders who want an editable artifact rather than a flat PNG.
+type ExcelExporter struct {
+	employeeRepo repository.EmployeeRepository
+	vacationRepo repository.VacationRepository
+	holidaySvc   holidays.Service
+}
+
+func NewExcelExporter() ExcelExporter {
+	return ExcelExporter{
+		employeeRepo: repository.NewEmployeeRepository(),
+		vacationRepo: repository.NewVacationRepository(),
+		holidaySvc:   holidays.NewService(),
+	}
+}
+
+// Export builds a workbook covering [from, to]: a "Calendar" sheet laid out
+// like the PNG, and a "Vacations" sheet of raw records for HR filtering.
+func (e ExcelExporter) Export(from, to time.Time) ([]byte, error) {
+	employees, err := e.employeeRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := e.vacationRepo.GetOccurrencesByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	holidayList, err := e.holidaySvc.GetByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	isHoliday := holidays.ToDateSet(holidayList)
+
+	days := int(to.Sub(from).Hours()/24) + 1
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	f.SetSheetName("Sheet1", calendarSheetName)
+	if err := e.writeCalendarSheet(f, employees, occurrences, from, to, days, isHoliday); err != nil {
+		return nil, err
+	}
+
+	f.NewSheet(vacationsSheetName)
+	if err := e.writeVacationsSheet(f, occurrences, from, to, isHoliday); err != nil {
+		return nil, err
+	}
+
+	f.SetActiveSheet(0)
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (e ExcelExporter) writeCalendarSheet(f *excelize.File, employees []models.Employee, occurrences []recurrence.Occurrence, from, to time.Time, days int, isHoliday map[string]bool) error {
+	sheet := calendarSheetName
+
+	f.SetCellValue(sheet, "A1", "Employee")
+
+	dateStyle, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	if err != nil {
+		return err
+	}
+	offDayStyle, err := f.NewStyle(&excelize.Style{
+		NumFmt: 14,
+		Fill:   excelize.Fill{Type: "pattern", Color: []string{"#F0F0F0"}, Pattern: 1},
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < days; i++ {
+		date := from.AddDate(0, 0, i)
+		col, err := excelize.ColumnNumberToName(i + 2)
+		if err != nil {
+			return err
+		}
+		cell := fmt.Sprintf("%s1", col)
+		f.SetCellValue(sheet, cell, date)
+
+		style := dateStyle
+		if holidays.IsOffDay(date, isHoliday) {
+			style = offDayStyle
+		}
+		f.SetCellStyle(sheet, cell, cell, style)
+	}
+
+	vacationsByEmployee := make(map[uint][]recurrence.Occurrence)
+	for _, occ := range occurrences {
+		vacationsByEmployee[occ.Vacation.EmployeeID] = append(vacationsByEmployee[occ.Vacation.EmployeeID], occ)
+	}
+
+	for i, emp := range employees {
+		row := i + 2
+		f.SetCellValue(sheet, fmt.Sprintf("A%d", row), emp.Name)
+
+		fillStyle, err := f.NewStyle(&excelize.Style{Fill: excelize.Fill{Type: "pattern", Color: []string{emp.Color}, Pattern: 1}})
+		if err != nil {
+			return err
+		}
+
+		for _, occ := range vacationsByEmployee[emp.ID] {
+			start, end := occ.Start, occ.End
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+
+			startIdx := int(start.Sub(from).Hours() / 24)
+			endIdx := int(end.Sub(from).Hours() / 24)
+			for d := startIdx; d <= endIdx && d < days; d++ {
+				col, err := excelize.ColumnNumberToName(d + 2)
+				if err != nil {
+					return err
+				}
+				cell := fmt.Sprintf("%s%d", col, row)
+				f.SetCellStyle(sheet, cell, cell, fillStyle)
+			}
+		}
+	}
+
+	lastCol, err := excelize.ColumnNumberToName(days + 1)
+	if err != nil {
+		return err
+	}
+	f.SetColWidth(sheet, "A", "A", 24)
+	f.SetColWidth(sheet, "B", lastCol, 10)
+
+	return f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		XSplit:      1,
+		YSplit:      1,
+		TopLeftCell: "B2",
+		ActivePane:  "bottomRight",
+	})
+}
+
+// writeVacationsSheet lists one row per occurrence, its Working Days column
+// reflecting only the portion of the occurrence within [from, to] (the same
+// window the Calendar sheet renders), computed from the already-fetched
+// isHoliday set rather than re-querying holidays per row.
+func (e ExcelExporter) writeVacationsSheet(f *excelize.File, occurrences []recurrence.Occurrence, from, to time.Time, isHoliday map[string]bool) error {
+	sheet := vacationsSheetName
+
+	headers := []string{"Employee", "Start Date", "End Date", "Working Days", "Description"}
+	for i, header := range headers {
+		col, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return err
+		}
+		f.SetCellValue(sheet, col+"1", header)
+	}
+
+	dateStyle, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	if err != nil {
+		return err
+	}
+
+	for i, occ := range occurrences {
+		row := i + 2
+
+		start, end := occ.Start, occ.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		workingDays := holidays.WorkingDays(start, end, isHoliday)
+
+		f.SetCellValue(sheet, fmt.Sprintf("A%d", row), occ.Vacation.Employee.Name)
+		f.SetCellValue(sheet, fmt.Sprintf("B%d", row), occ.Start)
+		f.SetCellValue(sheet, fmt.Sprintf("C%d", row), occ.End)
+		f.SetCellStyle(sheet, fmt.Sprintf("B%d", row), fmt.Sprintf("C%d", row), dateStyle)
+		f.SetCellValue(sheet, fmt.Sprintf("D%d", row), workingDays)
+		f.SetCellValue(sheet, fmt.Sprintf("E%d", row), occ.Vacation.Description)
+	}
+
+	return f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+}
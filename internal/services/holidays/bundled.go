@@ -0,0 +1,13 @@
+package holidays
+
+// bundledHolidays is a minimal, illustrative seed set keyed by region and
+// then by YYYY-MM-DD date. Real deployments are expected to point
+// HOLIDAY_ICS_URL at a proper feed for their region instead.
+var bundledHolidays = map[string]map[string]string{
+	"US": {
+		"2026-01-01": "New Year's Day",
+		"2026-07-04": "Independence Day",
+		"2026-11-26": "Thanksgiving Day",
+		"2026-12-25": "Christmas Day",
+	},
+}
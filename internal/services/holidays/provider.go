@@ -0,0 +1,131 @@
+// Package holidays seeds and serves public holidays so the calendar can
+// shade them and vacations can be measured in true working days.
+package holidays
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"vacation_calendar/internal/models"
+)
+
+// Provider fetches the public holidays for a region from some external
+// source, to be stored via HolidayRepository.Replace.
+type Provider interface {
+	Fetch(region string) ([]models.Holiday, error)
+}
+
+// BundledProvider serves a small built-in set of holidays per region,
+// useful as a default and in environments without internet access.
+type BundledProvider struct{}
+
+func NewBundledProvider() BundledProvider {
+	return BundledProvider{}
+}
+
+func (p BundledProvider) Fetch(region string) ([]models.Holiday, error) {
+	dates, ok := bundledHolidays[strings.ToUpper(region)]
+	if !ok {
+		return nil, fmt.Errorf("no bundled holidays for region %q", region)
+	}
+
+	holidays := make([]models.Holiday, 0, len(dates))
+	for dateStr, name := range dates {
+		date, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, models.Holiday{Date: date, Name: name, Region: region})
+	}
+	return holidays, nil
+}
+
+// icsHTTPClient bounds how long ICSProvider.Fetch will wait on a slow or
+// unresponsive HOLIDAY_ICS_URL, so POST /api/holidays/import can't hang the
+// request indefinitely.
+var icsHTTPClient = http.Client{Timeout: 10 * time.Second}
+
+// ICSProvider fetches a public-holiday calendar published as an RFC 5545
+// iCal feed, e.g. the ones used by HOLIDAY_ICS_URL-style integrations.
+type ICSProvider struct {
+	URL string
+}
+
+func NewICSProvider(url string) ICSProvider {
+	return ICSProvider{URL: url}
+}
+
+func (p ICSProvider) Fetch(region string) ([]models.Holiday, error) {
+	resp, err := icsHTTPClient.Get(p.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("holiday feed returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseICSHolidays(body, region)
+}
+
+// parseICSHolidays extracts one holiday per all-day VEVENT from an iCal
+// feed. It only understands the DTSTART;VALUE=DATE / SUMMARY pair, which is
+// all public-holiday feeds typically publish.
+func parseICSHolidays(data []byte, region string) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+
+	var inEvent bool
+	var name string
+	var date time.Time
+
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for _, line := range lines {
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			name = ""
+			date = time.Time{}
+		case line == "END:VEVENT":
+			if inEvent && !date.IsZero() {
+				holidays = append(holidays, models.Holiday{Date: date, Name: name, Region: region})
+			}
+			inEvent = false
+		case inEvent && strings.HasPrefix(line, "SUMMARY:"):
+			name = strings.TrimPrefix(line, "SUMMARY:")
+		case inEvent && strings.HasPrefix(line, "DTSTART"):
+			if idx := strings.LastIndex(line, ":"); idx != -1 {
+				if parsed, err := time.Parse("20060102", line[idx+1:]); err == nil {
+					date = parsed
+				}
+			}
+		}
+	}
+	return holidays, nil
+}
+
+// ProviderFromEnv builds a Provider from HOLIDAY_ICS_URL, falling back to
+// the bundled provider when it isn't set.
+func ProviderFromEnv() Provider {
+	if url := os.Getenv("HOLIDAY_ICS_URL"); url != "" {
+		return NewICSProvider(url)
+	}
+	return NewBundledProvider()
+}
+
+// RegionFromEnv reads HOLIDAY_REGION, defaulting to "US".
+func RegionFromEnv() string {
+	if region := os.Getenv("HOLIDAY_REGION"); region != "" {
+		return region
+	}
+	return "US"
+}
@@ -0,0 +1,44 @@
+package holidays
+
+import (
+	"time"
+
+	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/repository"
+)
+
+// Service seeds holidays from a pluggable Provider and serves them back.
+type Service struct {
+	repo     repository.HolidayRepository
+	provider Provider
+	region   string
+}
+
+func NewService() Service {
+	return Service{
+		repo:     repository.NewHolidayRepository(),
+		provider: ProviderFromEnv(),
+		region:   RegionFromEnv(),
+	}
+}
+
+func (s Service) GetAll() ([]models.Holiday, error) {
+	return s.repo.GetAll()
+}
+
+func (s Service) GetByDateRange(from, to time.Time) ([]models.Holiday, error) {
+	return s.repo.GetByDateRange(from, to, s.region)
+}
+
+// Refresh re-fetches the configured region from the provider and replaces
+// the stored holidays for it.
+func (s Service) Refresh() ([]models.Holiday, error) {
+	fetched, err := s.provider.Fetch(s.region)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Replace(s.region, fetched); err != nil {
+		return nil, err
+	}
+	return fetched, nil
+}
@@ -0,0 +1,38 @@
+package holidays
+
+import (
+	"time"
+
+	"vacation_calendar/internal/models"
+)
+
+// ToDateSet converts a holiday list into a YYYY-MM-DD lookup set, so
+// IsOffDay/WorkingDays can be called repeatedly without re-querying the
+// database for every check.
+func ToDateSet(holidayList []models.Holiday) map[string]bool {
+	isHoliday := make(map[string]bool, len(holidayList))
+	for _, h := range holidayList {
+		isHoliday[h.Date.Format("2006-01-02")] = true
+	}
+	return isHoliday
+}
+
+// IsOffDay reports whether date is a weekend or a holiday in isHoliday.
+func IsOffDay(date time.Time, isHoliday map[string]bool) bool {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return true
+	}
+	return isHoliday[date.Format("2006-01-02")]
+}
+
+// WorkingDays counts the days in [start, end] that are neither a weekend
+// nor a holiday in isHoliday.
+func WorkingDays(start, end time.Time, isHoliday map[string]bool) int {
+	days := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !IsOffDay(d, isHoliday) {
+			days++
+		}
+	}
+	return days
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vacation_calendar/internal/models"
+	"vacation_calendar/internal/repository"
+)
+
+const icalDateFormat = "20060102"
+const icalDateTimeFormat = "20060102T150405Z"
+
+// ICalendarService renders vacation records as RFC 5545 calendars so that
+// Google Calendar / Apple Calendar / Thunderbird can subscribe to them.
+type ICalendarService struct {
+	vacationRepo repository.VacationRepository
+}
+
+func NewICalendarService() ICalendarService {
+	return ICalendarService{
+		vacationRepo: repository.NewVacationRepository(),
+	}
+}
+
+// GenerateFeed renders every vacation in [from, to] as a VCALENDAR, one
+// VEVENT per vacation. A zero from/to means "no bound" and all vacations
+// are included. Recurring vacations are rendered as a single VEVENT with a
+// native RRULE (plus EXDATE for excluded instances), the same way any other
+// RFC 5545 recurring event is published, rather than expanded into one
+// VEVENT per occurrence.
+func (s ICalendarService) GenerateFeed(from, to time.Time) ([]byte, error) {
+	if from.IsZero() || to.IsZero() {
+		vacations, err := s.vacationRepo.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		return s.render(vacations), nil
+	}
+
+	vacations, err := s.vacationsOverlapping(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.render(vacations), nil
+}
+
+// GenerateEmployeeFeed renders only the vacations belonging to a single
+// employee, for the per-employee subscription endpoint.
+func (s ICalendarService) GenerateEmployeeFeed(employeeID uint, from, to time.Time) ([]byte, error) {
+	if from.IsZero() || to.IsZero() {
+		vacations, err := s.vacationRepo.GetByEmployeeID(employeeID)
+		if err != nil {
+			return nil, err
+		}
+		return s.render(vacations), nil
+	}
+
+	vacations, err := s.vacationsOverlapping(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []models.Vacation
+	for _, v := range vacations {
+		if v.EmployeeID == employeeID {
+			filtered = append(filtered, v)
+		}
+	}
+	return s.render(filtered), nil
+}
+
+// vacationsOverlapping returns the underlying vacation records - not
+// individual occurrences - that have at least one occurrence in [from, to].
+// Going through GetOccurrencesByDateRange (rather than GetByDateRange)
+// means a recurring vacation is found even when its own stored start/end
+// predates the window, since only the expansion knows where its later
+// occurrences land.
+func (s ICalendarService) vacationsOverlapping(from, to time.Time) ([]models.Vacation, error) {
+	occurrences, err := s.vacationRepo.GetOccurrencesByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]bool, len(occurrences))
+	vacations := make([]models.Vacation, 0, len(occurrences))
+	for _, occ := range occurrences {
+		if seen[occ.Vacation.ID] {
+			continue
+		}
+		seen[occ.Vacation.ID] = true
+		vacations = append(vacations, occ.Vacation)
+	}
+	return vacations, nil
+}
+
+func (s ICalendarService) render(vacations []models.Vacation) []byte {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//team-vacation-calendar//icalendar//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:Team Vacation Calendar")
+
+	now := time.Now().UTC()
+	for _, v := range vacations {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+vacationUID(v.ID))
+		writeLine(&b, "DTSTAMP:"+now.Format(icalDateTimeFormat))
+		writeLine(&b, "DTSTART;VALUE=DATE:"+v.StartDate.Format(icalDateFormat))
+		// iCal end dates are exclusive, so a vacation that ends on EndDate
+		// must report EndDate+1 to cover the full last day.
+		writeLine(&b, "DTEND;VALUE=DATE:"+v.EndDate.AddDate(0, 0, 1).Format(icalDateFormat))
+		if v.RRule != "" {
+			writeLine(&b, "RRULE:"+v.RRule)
+			if exdate := exdateLine(v.ExDates); exdate != "" {
+				writeLine(&b, exdate)
+			}
+		}
+		writeLine(&b, "SUMMARY:"+escapeText(summaryFor(v)))
+		if v.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(v.Description))
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func summaryFor(v models.Vacation) string {
+	name := v.Employee.Name
+	if name == "" {
+		name = fmt.Sprintf("Employee #%d", v.EmployeeID)
+	}
+	if v.Description != "" {
+		return name + " - " + v.Description
+	}
+	return name + " - vacation"
+}
+
+// exdateLine converts the comma-separated YYYY-MM-DD dates stored in
+// Vacation.ExDates into a single EXDATE content line, or "" if there are
+// none to exclude.
+func exdateLine(raw string) string {
+	if raw == "" {
+		return ""
+	}
+
+	var dates []string
+	for _, s := range strings.Split(raw, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		parsed, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			continue
+		}
+		dates = append(dates, parsed.Format(icalDateFormat))
+	}
+	if len(dates) == 0 {
+		return ""
+	}
+	return "EXDATE;VALUE=DATE:" + strings.Join(dates, ",")
+}
+
+func vacationUID(id uint) string {
+	return "vacation-" + strconv.FormatUint(uint64(id), 10) + "@team-vacation-calendar"
+}
+
+// escapeText escapes characters with special meaning in iCal content lines
+// per RFC 5545 section 3.3.11.
+func escapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\n", `\n`,
+	)
+	return r.Replace(s)
+}
+
+// writeLine appends a single logical content line to b, folding it at 75
+// octets as required by RFC 5545 section 3.1.
+func writeLine(b *strings.Builder, line string) {
+	b.WriteString(foldLine(line))
+	b.WriteString("\r\n")
+}
+
+func foldLine(line string) string {
+	const maxOctets = 75
+	if len(line) <= maxOctets {
+		return line
+	}
+
+	var folded strings.Builder
+	remaining := line
+	first := true
+	for len(remaining) > 0 {
+		limit := maxOctets
+		if !first {
+			limit = maxOctets - 1 // account for the leading space continuation
+		}
+		if len(remaining) <= limit {
+			if !first {
+				folded.WriteString("\r\n ")
+			}
+			folded.WriteString(remaining)
+			break
+		}
+
+		// Avoid splitting a multi-byte UTF-8 rune across lines.
+		cut := limit
+		for cut > 0 && isUTF8Continuation(remaining[cut]) {
+			cut--
+		}
+
+		if !first {
+			folded.WriteString("\r\n ")
+		}
+		folded.WriteString(remaining[:cut])
+		remaining = remaining[cut:]
+		first = false
+	}
+	return folded.String()
+}
+
+func isUTF8Continuation(b byte) bool {
+	return b&0xC0 == 0x80
+}
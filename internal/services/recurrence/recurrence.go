@@ -0,0 +1,92 @@
+// Package recurrence expands a models.Vacation carrying an RFC 5545 RRULE
+// into the concrete occurrences that fall within a query window.
+package recurrence
+
+import (
+	"strings"
+	"time"
+
+	"vacation_calendar/internal/models"
+
+	"github.com/teambition/rrule-go"
+)
+
+// Occurrence is one concrete instance of a vacation. For a non-recurring
+// vacation it is a direct copy of the stored dates; for a recurring one,
+// Start/End describe a single expansion while Vacation keeps the original
+// record (RRule, employee, description, ...) intact.
+type Occurrence struct {
+	Vacation models.Vacation
+	Start    time.Time
+	End      time.Time
+}
+
+// Expand returns every occurrence of v that overlaps [from, to]. A vacation
+// without an RRule expands to itself, matching the pre-recurrence behavior.
+func Expand(v models.Vacation, from, to time.Time) ([]Occurrence, error) {
+	if v.RRule == "" {
+		if v.EndDate.Before(from) || v.StartDate.After(to) {
+			return nil, nil
+		}
+		return []Occurrence{{Vacation: v, Start: v.StartDate, End: v.EndDate}}, nil
+	}
+
+	option, err := rrule.StrToROption(v.RRule)
+	if err != nil {
+		return nil, err
+	}
+	option.Dtstart = v.StartDate
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := v.EndDate.Sub(v.StartDate)
+	excluded := parseExDates(v.ExDates)
+
+	// Widen the left edge of the window by the vacation's own duration so
+	// an occurrence starting just before `from` but still overlapping it
+	// isn't missed; Between also handles clamping open-ended (no
+	// COUNT/UNTIL) rules to `to`.
+	starts := rule.Between(from.Add(-duration), to, true)
+
+	occurrences := make([]Occurrence, 0, len(starts))
+	for _, start := range starts {
+		if _, skip := excluded[start.Format("2006-01-02")]; skip {
+			continue
+		}
+
+		end := start.Add(duration)
+		if end.Before(from) || start.After(to) {
+			continue
+		}
+
+		occurrences = append(occurrences, Occurrence{Vacation: v, Start: start, End: end})
+	}
+	return occurrences, nil
+}
+
+// ValidateRRule reports whether s parses as a valid RFC 5545 recurrence
+// rule. An empty string is considered valid (no recurrence).
+func ValidateRRule(s string) error {
+	if s == "" {
+		return nil
+	}
+	_, err := rrule.StrToROption(s)
+	return err
+}
+
+func parseExDates(raw string) map[string]struct{} {
+	excluded := make(map[string]struct{})
+	if raw == "" {
+		return excluded
+	}
+	for _, s := range strings.Split(raw, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			excluded[s] = struct{}{}
+		}
+	}
+	return excluded
+}
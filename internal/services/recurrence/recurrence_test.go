@@ -0,0 +1,161 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"vacation_calendar/internal/models"
+)
+
+func TestExpand_NonRecurringPassThrough(t *testing.T) {
+	v := models.Vacation{
+		ID:        1,
+		StartDate: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	occurrences, err := Expand(v, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+	if len(occurrences) != 1 {
+		t.Fatalf("expected 1 occurrence, got %d", len(occurrences))
+	}
+	if !occurrences[0].Start.Equal(v.StartDate) || !occurrences[0].End.Equal(v.EndDate) {
+		t.Errorf("expected occurrence to match the stored dates, got %s - %s", occurrences[0].Start, occurrences[0].End)
+	}
+}
+
+// TestExpand_UntilVsCount checks that COUNT and UNTIL both terminate a
+// weekly series at the expected occurrence, rather than one silently
+// running open-ended.
+func TestExpand_UntilVsCount(t *testing.T) {
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC) // a Friday
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	countRule := models.Vacation{
+		ID:        1,
+		StartDate: start,
+		EndDate:   start,
+		RRule:     "FREQ=WEEKLY;BYDAY=FR;COUNT=5",
+	}
+	occurrences, err := Expand(countRule, from, to)
+	if err != nil {
+		t.Fatalf("Expand (COUNT) returned error: %v", err)
+	}
+	if len(occurrences) != 5 {
+		t.Fatalf("COUNT=5: expected 5 occurrences, got %d", len(occurrences))
+	}
+
+	// The same five Fridays (Jan 2 through Jan 30), but terminated by an
+	// explicit UNTIL instead of a COUNT.
+	untilRule := models.Vacation{
+		ID:        2,
+		StartDate: start,
+		EndDate:   start,
+		RRule:     "FREQ=WEEKLY;BYDAY=FR;UNTIL=20260130T000000Z",
+	}
+	occurrences, err = Expand(untilRule, from, to)
+	if err != nil {
+		t.Fatalf("Expand (UNTIL) returned error: %v", err)
+	}
+	if len(occurrences) != 5 {
+		t.Fatalf("UNTIL=2026-01-30: expected 5 occurrences, got %d", len(occurrences))
+	}
+	last := occurrences[len(occurrences)-1].Start
+	if !last.Equal(time.Date(2026, 1, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the last occurrence to be 2026-01-30, got %s", last.Format("2006-01-02"))
+	}
+}
+
+// TestExpand_ClampOpenEndedToWindow checks that a rule with neither COUNT
+// nor UNTIL (open-ended) is still bounded to the query window instead of
+// being expanded indefinitely.
+func TestExpand_ClampOpenEndedToWindow(t *testing.T) {
+	start := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC) // a Friday, long before the window
+	v := models.Vacation{
+		ID:        1,
+		StartDate: start,
+		EndDate:   start,
+		RRule:     "FREQ=WEEKLY;BYDAY=FR",
+	}
+
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	occurrences, err := Expand(v, from, to)
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+
+	// June 2026 has four Fridays: the 5th, 12th, 19th and 26th.
+	if len(occurrences) != 4 {
+		t.Fatalf("expected 4 occurrences clamped to June, got %d", len(occurrences))
+	}
+	for _, occ := range occurrences {
+		if occ.Start.Before(from) || occ.Start.After(to) {
+			t.Errorf("occurrence %s falls outside the query window", occ.Start.Format("2006-01-02"))
+		}
+	}
+}
+
+// TestExpand_DSTBoundary checks that a weekly recurrence keeps landing on
+// the same weekday across a DST transition, rather than drifting a day
+// because of the one-hour local-time shift.
+func TestExpand_DSTBoundary(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("zoneinfo for America/New_York not available in this environment: %v", err)
+	}
+
+	// 2026-03-06 is a Friday; the US spring-forward transition falls on
+	// 2026-03-08, in the middle of this four-week series.
+	start := time.Date(2026, 3, 6, 0, 0, 0, 0, loc)
+	v := models.Vacation{
+		ID:        1,
+		StartDate: start,
+		EndDate:   start,
+		RRule:     "FREQ=WEEKLY;BYDAY=FR;COUNT=4",
+	}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, loc)
+
+	occurrences, err := Expand(v, from, to)
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+	if len(occurrences) != 4 {
+		t.Fatalf("expected 4 occurrences, got %d", len(occurrences))
+	}
+	for _, occ := range occurrences {
+		if occ.Start.Weekday() != time.Friday {
+			t.Errorf("occurrence %s is not a Friday", occ.Start.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestExpand_ExDatesExcluded(t *testing.T) {
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC) // a Friday
+	v := models.Vacation{
+		ID:        1,
+		StartDate: start,
+		EndDate:   start,
+		RRule:     "FREQ=WEEKLY;BYDAY=FR;COUNT=3",
+		ExDates:   "2026-01-09",
+	}
+
+	occurrences, err := Expand(v, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+	if len(occurrences) != 2 {
+		t.Fatalf("expected 2 occurrences after excluding one, got %d", len(occurrences))
+	}
+	for _, occ := range occurrences {
+		if occ.Start.Format("2006-01-02") == "2026-01-09" {
+			t.Errorf("excluded date 2026-01-09 was not removed from the occurrences")
+		}
+	}
+}
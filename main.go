@@ -29,19 +29,43 @@ func main() {
 		employeeHandler := handlers.NewEmployeeHandler()
 		api.GET("/employees", employeeHandler.GetAll)
 		api.GET("/employees/:id", employeeHandler.GetByID)
+		api.GET("/employees/:id/ical", employeeHandler.ICalFeed)
 		api.POST("/employees", employeeHandler.Create)
 		api.PUT("/employees/:id", employeeHandler.Update)
 		api.DELETE("/employees/:id", employeeHandler.Delete)
 
 		vacationHandler := handlers.NewVacationHandler()
 		api.GET("/vacations", vacationHandler.GetAll)
+		api.GET("/vacations/ical", vacationHandler.ICalFeed)
 		api.GET("/vacations/:id", vacationHandler.GetByID)
 		api.POST("/vacations", vacationHandler.Create)
+		api.POST("/vacations/validate", vacationHandler.Validate)
 		api.PUT("/vacations/:id", vacationHandler.Update)
 		api.DELETE("/vacations/:id", vacationHandler.Delete)
 
 		calendarHandler := handlers.NewCalendarHandler()
 		api.GET("/calendar/generate", calendarHandler.Generate)
+		api.GET("/calendar/export.xlsx", calendarHandler.Export)
+
+		coverageHandler := handlers.NewCoverageHandler()
+		api.GET("/coverage", coverageHandler.GetCoverage)
+		api.GET("/freebusy", coverageHandler.GetFreeBusy)
+
+		groupHandler := handlers.NewGroupHandler()
+		api.GET("/groups", groupHandler.GetAll)
+		api.GET("/groups/:id", groupHandler.GetByID)
+		api.POST("/groups", groupHandler.Create)
+		api.PUT("/groups/:id", groupHandler.Update)
+		api.DELETE("/groups/:id", groupHandler.Delete)
+		api.POST("/groups/:id/members", groupHandler.AddMember)
+
+		holidayHandler := handlers.NewHolidayHandler()
+		api.GET("/holidays", holidayHandler.GetAll)
+		api.POST("/holidays/import", holidayHandler.Import)
+
+		blackoutHandler := handlers.NewBlackoutHandler()
+		api.GET("/blackouts", blackoutHandler.GetAll)
+		api.POST("/blackouts", blackoutHandler.Create)
 	}
 
 	log.Println("Starting server on http://localhost:8080")